@@ -0,0 +1,33 @@
+package version
+
+// Collection is a sortable slice of Versions, implementing sort.Interface
+// by delegating to Compare.
+type Collection []*Version
+
+func (v Collection) Len() int {
+	return len(v)
+}
+
+func (v Collection) Less(i, j int) bool {
+	return v[i].Compare(v[j]) < 0
+}
+
+func (v Collection) Swap(i, j int) {
+	v[i], v[j] = v[j], v[i]
+}
+
+// Latest returns the largest version in the collection that satisfies
+// the given constraints, or nil if none do.
+func (v Collection) Latest(c Constraints) *Version {
+	var latest *Version
+	for _, version := range v {
+		if !c.Check(version) {
+			continue
+		}
+		if latest == nil || version.Compare(latest) > 0 {
+			latest = version
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,58 @@
+package version
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestCollection_sort(t *testing.T) {
+	versions := []string{"1.1.0", "1.0.0", "1.2.0", "2.0.0-beta", "1.0.0-alpha"}
+	v := make(Collection, len(versions))
+	for i, str := range versions {
+		ver, err := NewVersion(str)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) err: %s", str, err)
+		}
+		v[i] = ver
+	}
+
+	sort.Sort(v)
+
+	expected := []string{"1.0.0-alpha", "1.0.0", "1.1.0", "1.2.0", "2.0.0-beta"}
+	for i, ver := range v {
+		if actual := ver.String(); actual != expected[i] {
+			t.Fatalf("index %d: got %s, want %s", i, actual, expected[i])
+		}
+	}
+}
+
+func TestCollection_latest(t *testing.T) {
+	versions := []string{"1.0.0", "1.1.0", "1.2.0", "2.0.0"}
+	v := make(Collection, len(versions))
+	for i, str := range versions {
+		ver, err := NewVersion(str)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) err: %s", str, err)
+		}
+		v[i] = ver
+	}
+
+	c, err := NewConstraint(">= 1.2, < 2.0")
+	if err != nil {
+		t.Fatalf("NewConstraint err: %s", err)
+	}
+
+	latest := v.Latest(c)
+	if latest == nil || latest.String() != "1.2.0" {
+		t.Fatalf("Latest() = %v, want 1.2.0", latest)
+	}
+
+	c, err = NewConstraint(">= 3.0")
+	if err != nil {
+		t.Fatalf("NewConstraint err: %s", err)
+	}
+
+	if latest := v.Latest(c); latest != nil {
+		t.Fatalf("Latest() = %v, want nil", latest)
+	}
+}
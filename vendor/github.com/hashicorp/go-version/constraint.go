@@ -0,0 +1,268 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Constraints represents a set of version constraints, such as
+// ">= 1.2, < 2.0 || ~> 1.5.0". A constraints string is made up of one
+// or more comma-separated constraints (all of which must match, i.e.
+// logical AND), and one or more of those AND-groups may be combined
+// with "||" (logical OR). A version satisfies the Constraints if it
+// satisfies at least one of the AND-groups.
+type Constraints []constraintGroup
+
+// constraintGroup is a single AND-group: every constraint in the
+// group must match for the group to match.
+type constraintGroup []*constraint
+
+// constraint represents a single constraint clause such as ">= 1.2"
+// or "~> 1.5.0".
+type constraint struct {
+	op     string
+	ref    *Version
+	fns    []constraintFunc
+	bounds []*Version
+}
+
+type constraintFunc func(v, c *Version) bool
+
+var constraintOperators = map[string]constraintFunc{
+	"=":  constraintEqual,
+	"!=": constraintNotEqual,
+	">":  constraintGreaterThan,
+	"<":  constraintLessThan,
+	">=": constraintGreaterThanEqual,
+	"<=": constraintLessThanEqual,
+}
+
+// The compiled regular expression used to test the validity of a single
+// constraint clause (an optional operator followed by a version).
+var constraintRegexp *regexp.Regexp
+
+// The raw regular expression string used to parse a single constraint
+// clause. Group 1 is the operator (empty meaning "="), group 2 is the
+// version.
+const constraintRegexpRaw = `^\s*(>=|<=|!=|~>|=|>|<)?\s*(` +
+	VersionRegexpRaw + `)\s*$`
+
+func init() {
+	constraintRegexp = regexp.MustCompile(constraintRegexpRaw)
+}
+
+// NewConstraint will parse one or more constraints from the given
+// constraint string. The string is a comma-separated list of
+// constraints (logical AND), and one or more such lists may be joined
+// with "||" (logical OR), e.g. ">= 1.2, < 2.0 || ~> 1.5.0".
+func NewConstraint(v string) (Constraints, error) {
+	ors := strings.Split(v, "||")
+	groups := make([]constraintGroup, len(ors))
+	for i, or := range ors {
+		ands := strings.Split(or, ",")
+		group := make(constraintGroup, 0, len(ands))
+		for _, and := range ands {
+			and = strings.TrimSpace(and)
+			if and == "" {
+				continue
+			}
+
+			c, err := parseConstraint(and)
+			if err != nil {
+				return nil, err
+			}
+
+			group = append(group, c)
+		}
+
+		if len(group) == 0 {
+			return nil, fmt.Errorf("Malformed constraint: %s", v)
+		}
+
+		groups[i] = group
+	}
+
+	return Constraints(groups), nil
+}
+
+// parseConstraint parses a single constraint clause, such as ">= 1.2"
+// or "~> 1.5.0".
+func parseConstraint(raw string) (*constraint, error) {
+	matches := constraintRegexp.FindStringSubmatch(raw)
+	if matches == nil {
+		return nil, fmt.Errorf("Malformed constraint: %s", raw)
+	}
+
+	op := matches[1]
+	if op == "" {
+		op = "="
+	}
+
+	check, err := NewVersion(matches[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if op == "~>" {
+		return &constraint{
+			op:     op,
+			ref:    check,
+			fns:    []constraintFunc{constraintGreaterThanEqual, constraintLessThan},
+			bounds: []*Version{check, pessimisticCeiling(check)},
+		}, nil
+	}
+
+	fn, ok := constraintOperators[op]
+	if !ok {
+		return nil, fmt.Errorf("Unknown constraint operator: %s", op)
+	}
+
+	return &constraint{
+		op:     op,
+		ref:    check,
+		fns:    []constraintFunc{fn},
+		bounds: []*Version{check},
+	}, nil
+}
+
+// pessimisticCeiling computes the exclusive upper bound implied by a
+// "~>" (pessimistic) constraint. "~> 1.2" means ">= 1.2, < 2.0" and
+// "~> 1.2.3" means ">= 1.2.3, < 1.3.0": the segment just before the
+// last one explicitly given is incremented, and everything after it
+// is zeroed.
+func pessimisticCeiling(v *Version) *Version {
+	segments := append([]int(nil), v.Segments()...)
+
+	si := v.si
+	if si < 2 {
+		si = 2
+	}
+
+	idx := si - 2
+	segments[idx]++
+	for i := idx + 1; i < len(segments); i++ {
+		segments[i] = 0
+	}
+
+	return &Version{segments: segments, si: len(segments)}
+}
+
+// Check tests if a version satisfies the constraints. A version
+// satisfies the constraints if it satisfies at least one of the
+// AND-groups that make it up.
+func (cs Constraints) Check(v *Version) bool {
+	for _, g := range cs {
+		if g.check(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the canonical form of the constraints, with every
+// version written out in full, e.g. ">= 1.2, < 2.0 || ~> 1.5.0"
+// becomes ">= 1.2.0, < 2.0.0 || ~> 1.5.0".
+func (cs Constraints) String() string {
+	ors := make([]string, len(cs))
+	for i, g := range cs {
+		ors[i] = g.String()
+	}
+
+	return strings.Join(ors, " || ")
+}
+
+// check tests a version against every clause in the group, applying
+// the prerelease visibility rule once for the whole group: a
+// prerelease version is only considered at all if at least one clause
+// in the group references a prerelease of the same MAJOR.MINOR.PATCH.
+// This matches common ecosystem semantics, e.g. "1.2.3-beta" does not
+// satisfy ">= 1.0.0", but does satisfy ">= 1.2.3-alpha, < 2.0.0".
+func (g constraintGroup) check(v *Version) bool {
+	if v.Prerelease() != "" && !g.prereleaseVisible(v) {
+		return false
+	}
+
+	for _, c := range g {
+		if !c.check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (g constraintGroup) prereleaseVisible(v *Version) bool {
+	for _, c := range g {
+		if prereleaseMatches(v, c.ref) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g constraintGroup) String() string {
+	ands := make([]string, len(g))
+	for i, c := range g {
+		ands[i] = c.String()
+	}
+
+	return strings.Join(ands, ", ")
+}
+
+// String returns the canonical form of a single constraint clause,
+// e.g. ">= 1.2.0" or "~> 1.5.0".
+func (c *constraint) String() string {
+	if c.op == "=" {
+		return c.ref.String()
+	}
+
+	return c.op + " " + c.ref.String()
+}
+
+// check tests a single constraint clause's comparator(s) against a
+// version. Prerelease visibility is gated once per constraintGroup,
+// not here.
+func (c *constraint) check(v *Version) bool {
+	for i, fn := range c.fns {
+		if !fn(v, c.bounds[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// prereleaseMatches reports whether c is itself a prerelease of the
+// same MAJOR.MINOR.PATCH as v.
+func prereleaseMatches(v, c *Version) bool {
+	if c.Prerelease() == "" {
+		return false
+	}
+
+	vs := v.Segments()
+	cs := c.Segments()
+	for i := 0; i < 3; i++ {
+		var a, b int
+		if i < len(vs) {
+			a = vs[i]
+		}
+		if i < len(cs) {
+			b = cs[i]
+		}
+		if a != b {
+			return false
+		}
+	}
+
+	return true
+}
+
+func constraintEqual(v, c *Version) bool           { return v.Compare(c) == 0 }
+func constraintNotEqual(v, c *Version) bool         { return v.Compare(c) != 0 }
+func constraintGreaterThan(v, c *Version) bool      { return v.Compare(c) > 0 }
+func constraintLessThan(v, c *Version) bool         { return v.Compare(c) < 0 }
+func constraintGreaterThanEqual(v, c *Version) bool { return v.Compare(c) >= 0 }
+func constraintLessThanEqual(v, c *Version) bool    { return v.Compare(c) <= 0 }
@@ -0,0 +1,96 @@
+package version
+
+import (
+	"testing"
+)
+
+func TestNewConstraint(t *testing.T) {
+	cases := []struct {
+		input string
+		count int
+		err   bool
+	}{
+		{">= 1.2", 1, false},
+		{"1.0", 1, false},
+		{">= 1.x", 0, true},
+		{">= 1.2, < 2.0", 1, false},
+		{">= 1.2, < 2.0 || ~> 1.5.0", 2, false},
+		{"", 0, true},
+	}
+
+	for _, tc := range cases {
+		c, err := NewConstraint(tc.input)
+		if tc.err && err == nil {
+			t.Fatalf("expected error for %q", tc.input)
+		}
+		if !tc.err && err != nil {
+			t.Fatalf("NewConstraint(%q) err: %s", tc.input, err)
+		}
+		if !tc.err && len(c) != tc.count {
+			t.Fatalf("NewConstraint(%q) groups = %d, want %d", tc.input, len(c), tc.count)
+		}
+	}
+}
+
+func TestConstraintsCheck(t *testing.T) {
+	cases := []struct {
+		constraint string
+		version    string
+		check      bool
+	}{
+		{">= 1.0, < 2.0", "1.5.0", true},
+		{">= 1.0, < 2.0", "2.0.0", false},
+		{">= 1.0, < 2.0 || ~> 3.0", "3.2.0", true},
+		{">= 1.0, < 2.0 || ~> 3.0", "3.2.0-beta", false},
+		{"~> 1.2", "1.9.9", true},
+		{"~> 1.2", "2.0.0", false},
+		{"~> 1.2.3", "1.2.9", true},
+		{"~> 1.2.3", "1.3.0", false},
+		{"!= 1.2.0", "1.2.1", true},
+		{"!= 1.2.0", "1.2.0", false},
+		{">= 1.0.0", "1.2.3-beta", false},
+		{">= 1.2.3-alpha", "1.2.3-beta", true},
+		{">= 1.2.3-alpha", "1.3.0-beta", false},
+		{">= 1.2.3-beta, < 2.0.0", "1.2.3-beta", true},
+		{">= 1.2.3-beta, < 2.0.0", "1.2.3-rc", true},
+	}
+
+	for _, tc := range cases {
+		c, err := NewConstraint(tc.constraint)
+		if err != nil {
+			t.Fatalf("NewConstraint(%q) err: %s", tc.constraint, err)
+		}
+
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) err: %s", tc.version, err)
+		}
+
+		if actual := c.Check(v); actual != tc.check {
+			t.Fatalf("Check(%q against %q) = %v, want %v",
+				tc.version, tc.constraint, actual, tc.check)
+		}
+	}
+}
+
+func TestConstraintsString(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{">= 1.2", ">= 1.2.0"},
+		{">=1.2,<2.0", ">= 1.2.0, < 2.0.0"},
+		{">= 1.2, < 2.0 || ~> 1.5.0", ">= 1.2.0, < 2.0.0 || ~> 1.5.0"},
+	}
+
+	for _, tc := range cases {
+		c, err := NewConstraint(tc.input)
+		if err != nil {
+			t.Fatalf("NewConstraint(%q) err: %s", tc.input, err)
+		}
+
+		if actual := c.String(); actual != tc.expected {
+			t.Fatalf("String() = %q, want %q", actual, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package version
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalText implements encoding.TextMarshaler, so a Version round-trips
+// through anything built on top of it: JSON, YAML, TOML, environment
+// variables, and the flag package.
+func (v *Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Version) UnmarshalText(data []byte) error {
+	parsed, err := NewVersion(string(data))
+	if err != nil {
+		return err
+	}
+
+	*v = *parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v *Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON
+// string or a JSON null, in which case the Version is left as its
+// zero value.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*v = Version{}
+		return nil
+	}
+
+	return v.UnmarshalText([]byte(*s))
+}
+
+// Value implements driver.Valuer, allowing a Version to be written to a
+// database column as TEXT.
+func (v *Version) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	return v.String(), nil
+}
+
+// Scan implements sql.Scanner, allowing a Version to be read back from a
+// database column stored as TEXT.
+func (v *Version) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case nil:
+		*v = Version{}
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(s))
+	case []byte:
+		return v.UnmarshalText(s)
+	default:
+		return fmt.Errorf("version: cannot scan %T into Version", src)
+	}
+}
@@ -0,0 +1,176 @@
+package version
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestVersionJSONRoundTrip(t *testing.T) {
+	type wrapper struct {
+		V *Version
+	}
+
+	in := wrapper{V: Must(NewVersion("1.2.3-beta+build"))}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal err: %s", err)
+	}
+
+	var out wrapper
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal err: %s", err)
+	}
+
+	if out.V == nil || !out.V.Equal(in.V) {
+		t.Fatalf("round-trip mismatch: got %v, want %v", out.V, in.V)
+	}
+}
+
+func TestVersionJSONNull(t *testing.T) {
+	type wrapper struct {
+		V *Version
+	}
+
+	var out wrapper
+	if err := json.Unmarshal([]byte(`{"V":null}`), &out); err != nil {
+		t.Fatalf("Unmarshal err: %s", err)
+	}
+
+	if out.V != nil {
+		t.Fatalf("expected nil V, got %v", out.V)
+	}
+}
+
+func TestVersionValueAndScan(t *testing.T) {
+	v := Must(NewVersion("1.2.3"))
+
+	val, err := v.Value()
+	if err != nil {
+		t.Fatalf("Value err: %s", err)
+	}
+
+	var scanned Version
+	if err := scanned.Scan(val); err != nil {
+		t.Fatalf("Scan err: %s", err)
+	}
+	if !scanned.Equal(v) {
+		t.Fatalf("Scan mismatch: got %s, want %s", scanned.String(), v.String())
+	}
+
+	if err := scanned.Scan([]byte("2.0.0")); err != nil {
+		t.Fatalf("Scan []byte err: %s", err)
+	}
+	if scanned.String() != "2.0.0" {
+		t.Fatalf("Scan []byte = %s, want 2.0.0", scanned.String())
+	}
+
+	if err := scanned.Scan(nil); err != nil {
+		t.Fatalf("Scan nil err: %s", err)
+	}
+	if scanned.String() != "" {
+		t.Fatalf("Scan nil left %s, want zero value", scanned.String())
+	}
+
+	if err := scanned.Scan(42); err == nil {
+		t.Fatal("expected error scanning unsupported type")
+	}
+}
+
+// fakeTextDriver is a minimal database/sql driver, backed by nothing
+// but the standard library, used below to round-trip a Version through
+// the real database/sql Exec/Scan code paths. This module's go.mod
+// declares no dependencies and the tree vendors only
+// hashicorp/go-version itself, so a real mock driver
+// such as DATA-DOG/go-sqlmock isn't available to pull in; this fake
+// stands in for it rather than testing Value/Scan in isolation.
+type fakeTextDriver struct {
+	stored driver.Value
+}
+
+func (d *fakeTextDriver) Open(name string) (driver.Conn, error) {
+	return &fakeTextConn{driver: d}, nil
+}
+
+type fakeTextConn struct {
+	driver *fakeTextDriver
+}
+
+func (c *fakeTextConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeTextStmt{conn: c}, nil
+}
+
+func (c *fakeTextConn) Close() error { return nil }
+
+func (c *fakeTextConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fakeTextDriver: transactions not supported")
+}
+
+type fakeTextStmt struct {
+	conn *fakeTextConn
+}
+
+func (s *fakeTextStmt) Close() error  { return nil }
+func (s *fakeTextStmt) NumInput() int { return -1 }
+
+func (s *fakeTextStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if len(args) != 1 {
+		return nil, errors.New("fakeTextStmt: expected exactly one arg")
+	}
+
+	s.conn.driver.stored = args[0]
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeTextStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeTextRows{value: s.conn.driver.stored}, nil
+}
+
+type fakeTextRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (r *fakeTextRows) Columns() []string { return []string{"v"} }
+func (r *fakeTextRows) Close() error      { return nil }
+
+func (r *fakeTextRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+func init() {
+	sql.Register("goversion_faketext", &fakeTextDriver{})
+}
+
+func TestVersionDatabaseSQLRoundTrip(t *testing.T) {
+	db, err := sql.Open("goversion_faketext", "")
+	if err != nil {
+		t.Fatalf("sql.Open err: %s", err)
+	}
+	defer db.Close()
+
+	v := Must(NewVersion("1.2.3-beta"))
+
+	if _, err := db.Exec("INSERT INTO versions (v) VALUES (?)", v); err != nil {
+		t.Fatalf("Exec err: %s", err)
+	}
+
+	var scanned Version
+	if err := db.QueryRow("SELECT v FROM versions").Scan(&scanned); err != nil {
+		t.Fatalf("Scan err: %s", err)
+	}
+
+	if !scanned.Equal(v) {
+		t.Fatalf("round-trip mismatch: got %s, want %s", scanned.String(), v.String())
+	}
+}
@@ -12,6 +12,14 @@ import (
 // The compiled regular expression used to test the validity of a version.
 var versionRegexp *regexp.Regexp
 
+// The compiled regular expression used to test the validity of a
+// strict SemVer 2.0 version.
+var semverRegexp *regexp.Regexp
+
+// The compiled regular expression used to validate a single SemVer 2.0
+// prerelease/build identifier.
+var semverIdentifierRegexp *regexp.Regexp
+
 // The raw regular expression string used for testing the validity
 // of a version.
 const VersionRegexpRaw string = `v?([0-9]+(\.[0-9]+)*?)` +
@@ -19,16 +27,31 @@ const VersionRegexpRaw string = `v?([0-9]+(\.[0-9]+)*?)` +
 	`(\+([0-9A-Za-z\-]+(\.[0-9A-Za-z\-]+)*))?` +
 	`?`
 
+// SemverRegexpRaw is the raw regular expression string used for testing
+// the validity of a strict SemVer 2.0 version (https://semver.org). Unlike
+// VersionRegexpRaw, it requires exactly three numeric components, forbids
+// leading zeros on those components, and requires the "-" before any
+// prerelease identifiers. Leading zeros on numeric prerelease
+// identifiers are not caught here; NewSemver rejects them separately.
+const SemverRegexpRaw string = `v?([0-9]|[1-9][0-9]*)\.([0-9]|[1-9][0-9]*)\.([0-9]|[1-9][0-9]*)` +
+	`(-([0-9A-Za-z\-]+(\.[0-9A-Za-z\-]+)*))?` +
+	`(\+([0-9A-Za-z\-]+(\.[0-9A-Za-z\-]+)*))?` +
+	`?`
+
 // Version represents a single version.
 type Version struct {
 	metadata string
+	original string
 	pre      string
 	segments []int
 	si       int
+	semver   bool
 }
 
 func init() {
 	versionRegexp = regexp.MustCompile("^" + VersionRegexpRaw + "$")
+	semverRegexp = regexp.MustCompile("^" + SemverRegexpRaw + "$")
+	semverIdentifierRegexp = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
 }
 
 // NewVersion parses the given version and returns a new
@@ -61,12 +84,102 @@ func NewVersion(v string) (*Version, error) {
 
 	return &Version{
 		metadata: matches[7],
+		original: v,
 		pre:      matches[4],
 		segments: segments,
 		si:       si,
 	}, nil
 }
 
+// NewSemver parses the given version as a strict SemVer 2.0 version
+// (https://semver.org) and returns a new Version. Unlike NewVersion,
+// it requires exactly three numeric components, rejects leading zeros
+// on any numeric component or numeric prerelease identifier, and
+// requires the "-" before prerelease identifiers if any are present.
+// A Version produced this way also causes Compare to follow SemVer
+// 2.0 precedence rules strictly when compared to another such Version.
+func NewSemver(v string) (*Version, error) {
+	matches := semverRegexp.FindStringSubmatch(v)
+	if matches == nil {
+		return nil, fmt.Errorf("Malformed version: %s", v)
+	}
+
+	segments := make([]int, 3)
+	for i := 0; i < 3; i++ {
+		val, err := strconv.ParseInt(matches[i+1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Error parsing version: %s", err)
+		}
+
+		segments[i] = int(val)
+	}
+
+	pre := matches[5]
+	if err := validateSemverPrerelease(pre); err != nil {
+		return nil, err
+	}
+
+	metadata := matches[8]
+	if err := validateSemverMetadata(metadata); err != nil {
+		return nil, err
+	}
+
+	return &Version{
+		metadata: metadata,
+		original: v,
+		pre:      pre,
+		segments: segments,
+		si:       3,
+		semver:   true,
+	}, nil
+}
+
+// validateSemverPrerelease enforces the SemVer 2.0 rules for a
+// dot-separated run of prerelease identifiers: each identifier must be
+// non-empty, match `[0-9A-Za-z-]+`, and numeric identifiers must not
+// have leading zeros (numeric prerelease identifiers are compared
+// numerically, so "01" and "1" would otherwise be ambiguous).
+// For input that went through NewSemver, SemverRegexpRaw already
+// guarantees non-empty identifiers and the character set, but not the
+// leading-zero rule; SetPrerelease calls this on arbitrary
+// caller-supplied strings, so every rule is checked here.
+func validateSemverPrerelease(s string) error {
+	return validateSemverIdentifiers(s, true)
+}
+
+// validateSemverMetadata enforces the SemVer 2.0 rules for a
+// dot-separated run of build-metadata identifiers: each identifier
+// must be non-empty and match `[0-9A-Za-z-]+`. Unlike prerelease
+// identifiers, metadata is never compared, so leading zeros are
+// allowed ("1.2.3+01" and "1.2.3+0123.abc" are valid SemVer 2.0
+// versions).
+func validateSemverMetadata(s string) error {
+	return validateSemverIdentifiers(s, false)
+}
+
+func validateSemverIdentifiers(s string, rejectLeadingZero bool) error {
+	if s == "" {
+		return nil
+	}
+
+	for _, id := range strings.Split(s, ".") {
+		if id == "" || !semverIdentifierRegexp.MatchString(id) {
+			return fmt.Errorf(
+				"Malformed version: invalid identifier %q", id)
+		}
+
+		if rejectLeadingZero && len(id) > 1 && id[0] == '0' {
+			if _, err := strconv.ParseInt(id, 10, 64); err == nil {
+				return fmt.Errorf(
+					"Malformed version: numeric identifier %q has a leading zero", id)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Must is a helper that wraps a call to a function returning (*Version, error)
 // and panics if error is non-nil.
 func Must(v *Version, err error) *Version {
@@ -106,6 +219,9 @@ func (v *Version) Compare(other *Version) int {
 			return -1
 		}
 
+		if v.semver && other.semver {
+			return compareSemverPrereleases(preSelf, preOther)
+		}
 		return comparePrereleases(preSelf, preOther)
 	}
 
@@ -230,6 +346,73 @@ func comparePrereleases(v string, other string) int {
 	return 0
 }
 
+// compareSemverPrereleases compares two prerelease strings following
+// strict SemVer 2.0 precedence rules: identifiers are compared
+// dot-separated-part by dot-separated-part, numeric identifiers compare
+// numerically and are always lower precedence than alphanumeric ones,
+// alphanumeric identifiers compare lexically, and a shorter list of
+// identifiers is lower precedence than a longer one when all preceding
+// identifiers are equal.
+func compareSemverPrereleases(v string, other string) int {
+	if v == other {
+		return 0
+	}
+
+	selfParts := strings.Split(v, ".")
+	otherParts := strings.Split(other, ".")
+
+	n := len(selfParts)
+	if len(otherParts) < n {
+		n = len(otherParts)
+	}
+
+	for i := 0; i < n; i++ {
+		if cmp := compareSemverIdentifier(selfParts[i], otherParts[i]); cmp != 0 {
+			return cmp
+		}
+	}
+
+	switch {
+	case len(selfParts) < len(otherParts):
+		return -1
+	case len(selfParts) > len(otherParts):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareSemverIdentifier(a, b string) int {
+	an, aErr := strconv.ParseInt(a, 10, 64)
+	bn, bErr := strconv.ParseInt(b, 10, 64)
+	// An identifier with a leading "-" (such as "-1") is alphanumeric,
+	// even though ParseInt accepts it.
+	aNumeric := aErr == nil && !strings.HasPrefix(a, "-")
+	bNumeric := bErr == nil && !strings.HasPrefix(b, "-")
+
+	switch {
+	case aNumeric && bNumeric:
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		default:
+			return 0
+		}
+	case aNumeric && !bNumeric:
+		return -1
+	case !aNumeric && bNumeric:
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // Equal tests if two versions are equal.
 func (v *Version) Equal(o *Version) bool {
 	return v.Compare(o) == 0
@@ -245,6 +428,101 @@ func (v *Version) LessThan(o *Version) bool {
 	return v.Compare(o) < 0
 }
 
+// IncMajor returns a new Version with the major segment incremented by
+// one, the minor and patch segments reset to zero, and any prerelease
+// and metadata cleared.
+func (v *Version) IncMajor() *Version {
+	segments := v.coreSegments()
+	segments[0]++
+	segments[1] = 0
+	segments[2] = 0
+
+	return &Version{segments: segments, si: 3, semver: v.semver}
+}
+
+// IncMinor returns a new Version with the minor segment incremented by
+// one, the patch segment reset to zero, and any prerelease and
+// metadata cleared.
+func (v *Version) IncMinor() *Version {
+	segments := v.coreSegments()
+	segments[1]++
+	segments[2] = 0
+
+	return &Version{segments: segments, si: 3, semver: v.semver}
+}
+
+// IncPatch returns a new Version with any prerelease and metadata
+// cleared. If the version has no prerelease, the patch segment is
+// also incremented by one; if it does, the patch segment is left as
+// is, the common "release the pre-release" behavior.
+func (v *Version) IncPatch() *Version {
+	segments := v.coreSegments()
+	if v.pre == "" {
+		segments[2]++
+	}
+
+	return &Version{segments: segments, si: 3, semver: v.semver}
+}
+
+// coreSegments returns a copy of the MAJOR.MINOR.PATCH segments,
+// padding with zeros if fewer than three were present.
+func (v *Version) coreSegments() []int {
+	segments := append([]int(nil), v.Segments()...)
+	for len(segments) < 3 {
+		segments = append(segments, 0)
+	}
+	return segments[:3]
+}
+
+// SetPrerelease returns a new Version with its prerelease information
+// set to the given string, leaving the receiver unchanged. An empty
+// string clears the prerelease. The identifiers are validated against
+// the strict SemVer 2.0 rules enforced by NewSemver.
+func (v *Version) SetPrerelease(prerelease string) (*Version, error) {
+	if err := validateSemverPrerelease(prerelease); err != nil {
+		return nil, err
+	}
+
+	result := *v
+	result.pre = prerelease
+	result.original = ""
+	return &result, nil
+}
+
+// SetMetadata returns a new Version with its build metadata set to the
+// given string, leaving the receiver unchanged. An empty string clears
+// the metadata. The identifiers are validated against the strict
+// SemVer 2.0 rules enforced by NewSemver.
+func (v *Version) SetMetadata(metadata string) (*Version, error) {
+	if err := validateSemverMetadata(metadata); err != nil {
+		return nil, err
+	}
+
+	result := *v
+	result.metadata = metadata
+	result.original = ""
+	return &result, nil
+}
+
+// Original returns the exact string that was parsed to produce this
+// Version, verbatim (including any leading "v" or missing segments).
+// For a Version produced by a derived operation, such as IncMajor, this
+// is the canonical String() of the result.
+func (v *Version) Original() string {
+	if v.original == "" {
+		return v.String()
+	}
+
+	return v.original
+}
+
+// Core returns the MAJOR.MINOR.PATCH portion of the version as a
+// string, with no prerelease or metadata information.
+func (v *Version) Core() string {
+	segments := v.coreSegments()
+	return fmt.Sprintf("%d.%d.%d", segments[0], segments[1], segments[2])
+}
+
 // Metadata returns any metadata that was part of the version
 // string.
 //
@@ -0,0 +1,170 @@
+package version
+
+import "testing"
+
+func TestNewSemver(t *testing.T) {
+	cases := []struct {
+		version string
+		err     bool
+	}{
+		{"1.2.3", false},
+		{"v1.2.3", false},
+		{"1.2.3-beta.1", false},
+		{"1.2.3-beta.1+build.1", false},
+		{"1.2", true},
+		{"1.2.3.4", true},
+		{"01.2.3", true},
+		{"1.2.3-01", true},
+		{"1.2.3-beta.01", true},
+		{"1.2.3-", true},
+		{"1.2.3-.", true},
+		{"1.2.3+01", false},
+		{"1.2.3+0123.abc", false},
+	}
+
+	for _, tc := range cases {
+		_, err := NewSemver(tc.version)
+		if tc.err && err == nil {
+			t.Fatalf("expected error parsing %q", tc.version)
+		}
+		if !tc.err && err != nil {
+			t.Fatalf("NewSemver(%q) err: %s", tc.version, err)
+		}
+	}
+}
+
+func TestVersionCompare_semverPrerelease(t *testing.T) {
+	// Ordering taken from the SemVer 2.0 spec's precedence example.
+	order := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+
+	for i := 0; i < len(order)-1; i++ {
+		lo, err := NewSemver(order[i])
+		if err != nil {
+			t.Fatalf("NewSemver(%q) err: %s", order[i], err)
+		}
+		hi, err := NewSemver(order[i+1])
+		if err != nil {
+			t.Fatalf("NewSemver(%q) err: %s", order[i+1], err)
+		}
+
+		if !lo.LessThan(hi) {
+			t.Fatalf("expected %s < %s", order[i], order[i+1])
+		}
+		if !hi.GreaterThan(lo) {
+			t.Fatalf("expected %s > %s", order[i+1], order[i])
+		}
+	}
+}
+
+func TestVersionInc(t *testing.T) {
+	v := Must(NewVersion("1.2.3-beta+build"))
+
+	if got := v.IncMajor().String(); got != "2.0.0" {
+		t.Fatalf("IncMajor() = %s, want 2.0.0", got)
+	}
+	if got := v.IncMinor().String(); got != "1.3.0" {
+		t.Fatalf("IncMinor() = %s, want 1.3.0", got)
+	}
+	if got := v.IncPatch().String(); got != "1.2.3" {
+		t.Fatalf("IncPatch() (with prerelease) = %s, want 1.2.3", got)
+	}
+
+	release := Must(NewVersion("1.2.3"))
+	if got := release.IncPatch().String(); got != "1.2.4" {
+		t.Fatalf("IncPatch() (no prerelease) = %s, want 1.2.4", got)
+	}
+
+	// original is unchanged
+	if v.String() != "1.2.3-beta+build" {
+		t.Fatalf("receiver mutated: %s", v.String())
+	}
+}
+
+func TestVersionSetPrereleaseAndMetadata(t *testing.T) {
+	v := Must(NewVersion("1.2.3"))
+
+	withPre, err := v.SetPrerelease("beta.1")
+	if err != nil {
+		t.Fatalf("SetPrerelease err: %s", err)
+	}
+	if got := withPre.String(); got != "1.2.3-beta.1" {
+		t.Fatalf("SetPrerelease() = %s, want 1.2.3-beta.1", got)
+	}
+	if v.Prerelease() != "" {
+		t.Fatalf("receiver mutated: %s", v.Prerelease())
+	}
+
+	withMeta, err := withPre.SetMetadata("build.7")
+	if err != nil {
+		t.Fatalf("SetMetadata err: %s", err)
+	}
+	if got := withMeta.String(); got != "1.2.3-beta.1+build.7" {
+		t.Fatalf("SetMetadata() = %s, want 1.2.3-beta.1+build.7", got)
+	}
+
+	if got := withPre.Original(); got != withPre.String() {
+		t.Fatalf("Original() after SetPrerelease = %q, want %q (stale original)", got, withPre.String())
+	}
+	if got := withMeta.Original(); got != withMeta.String() {
+		t.Fatalf("Original() after SetMetadata = %q, want %q (stale original)", got, withMeta.String())
+	}
+
+	if _, err := v.SetPrerelease("01"); err == nil {
+		t.Fatal("expected error for leading-zero prerelease identifier")
+	}
+	if _, err := v.SetPrerelease("beta..1"); err == nil {
+		t.Fatal("expected error for empty prerelease identifier")
+	}
+	if _, err := v.SetPrerelease("beta_1"); err == nil {
+		t.Fatal("expected error for invalid character in prerelease identifier")
+	}
+	if _, err := v.SetPrerelease("beta 1"); err == nil {
+		t.Fatal("expected error for space in prerelease identifier")
+	}
+	if _, err := v.SetMetadata("build_1"); err == nil {
+		t.Fatal("expected error for invalid character in metadata identifier")
+	}
+
+	// Unlike prerelease identifiers, metadata identifiers are never
+	// compared, so a leading zero is valid SemVer 2.0.
+	withZeroMeta, err := v.SetMetadata("01")
+	if err != nil {
+		t.Fatalf("SetMetadata(\"01\") err: %s", err)
+	}
+	if got := withZeroMeta.String(); got != "1.2.3+01" {
+		t.Fatalf("SetMetadata(\"01\") = %s, want 1.2.3+01", got)
+	}
+}
+
+func TestVersionOriginalAndCore(t *testing.T) {
+	v := Must(NewVersion("v1.2"))
+
+	if got := v.Original(); got != "v1.2" {
+		t.Fatalf("Original() = %q, want %q", got, "v1.2")
+	}
+	if got := v.String(); got != "1.2.0" {
+		t.Fatalf("String() = %q, want %q", got, "1.2.0")
+	}
+	if got := v.Core(); got != "1.2.0" {
+		t.Fatalf("Core() = %q, want %q", got, "1.2.0")
+	}
+
+	pre := Must(NewVersion("1.2.3-beta+build"))
+	if got := pre.Core(); got != "1.2.3" {
+		t.Fatalf("Core() = %q, want %q", got, "1.2.3")
+	}
+
+	derived := v.IncMinor()
+	if got := derived.Original(); got != derived.String() {
+		t.Fatalf("Original() on derived version = %q, want %q", got, derived.String())
+	}
+}